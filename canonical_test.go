@@ -0,0 +1,62 @@
+package main
+
+import "testing"
+
+// mirroredLineProblem models a simplified 8-puzzle-style symmetry: states
+// "A0".."A2" and their mirror images "B0".."B2" represent two reflections of
+// the same underlying configuration, both leading to the goal. counter is
+// incremented once per Actions call, i.e. once per node expanded.
+func mirroredLineProblem(canonical func(string) string, counter *int) Problem {
+	edges := map[string][]string{
+		"Start": {"A0", "B0"},
+		"A0":    {"A1"},
+		"A1":    {"A2"},
+		"A2":    {"G"},
+		"B0":    {"B1"},
+		"B1":    {"B2"},
+		"B2":    {"G"},
+	}
+
+	return Problem{
+		InitialState: Node{State: "Start"},
+		Actions: func(state string) []string {
+			*counter++
+			return edges[state]
+		},
+		Result: func(state string, action string) string {
+			return action
+		},
+		GoalTest: func(state string) bool {
+			return state == "G"
+		},
+		Cost: func(stateA string, action string, stateB string) int {
+			return 1
+		},
+		Canonical: canonical,
+	}
+}
+
+// mirrorCanonical collapses each mirrored Bn state onto its An counterpart.
+func mirrorCanonical(state string) string {
+	mirror := map[string]string{"B0": "A0", "B1": "A1", "B2": "A2"}
+	if canonicalState, ok := mirror[state]; ok {
+		return canonicalState
+	}
+	return state
+}
+
+func TestCanonicalizationReducesNodesExpanded(t *testing.T) {
+	var withoutCanonical, withCanonical int
+
+	if _, err := GraphSearch(mirroredLineProblem(nil, &withoutCanonical)); err != nil {
+		t.Fatalf("GraphSearch without canonicalization returned error: %v", err)
+	}
+
+	if _, err := GraphSearch(mirroredLineProblem(mirrorCanonical, &withCanonical)); err != nil {
+		t.Fatalf("GraphSearch with canonicalization returned error: %v", err)
+	}
+
+	if withCanonical >= withoutCanonical {
+		t.Fatalf("canonicalization did not reduce nodes expanded: without=%d, with=%d", withoutCanonical, withCanonical)
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrCutoff indicates DepthLimitedSearch abandoned a branch because it hit
+// its depth limit, not because the branch was exhausted. IterativeDeepeningSearch
+// uses this to tell "try again deeper" apart from genuine failure.
+var ErrCutoff = errors.New("search cutoff at depth limit")
+
+// DepthFirstGraphSearch is GraphSearch with a LIFOFrontier, so it expands
+// depth-first while still tracking explored states.
+func DepthFirstGraphSearch(problem Problem) (*Solution, error) {
+	return GenericSearch(problem, NewLIFOFrontier(problem.canonicalState), SearchOptions{GraphSearch: true})
+}
+
+// DepthLimitedSearch explores depth-first but never expands a node beyond
+// limit actions from the initial state, returning ErrCutoff when the limit
+// cut off the search before every branch was exhausted. It skips expanding a
+// child whose Problem.Canonical state already appears among its own
+// ancestors, pruning symmetric states and cycles along the current path.
+func DepthLimitedSearch(problem Problem, limit int) (*Solution, error) {
+	return depthLimitedSearch(problem, problem.InitialState, limit)
+}
+
+func depthLimitedSearch(problem Problem, node Node, limit int) (*Solution, error) {
+	if problem.GoalTest(node.State) {
+		return SolutionPath(node), nil
+	}
+	if limit == 0 {
+		return nil, ErrCutoff
+	}
+
+	cutoffOccurred := false
+	for _, action := range problem.Actions(node.State) {
+		child := ChildNode(problem, node, action)
+		if isOnPath(problem, node, child.State) {
+			continue
+		}
+		solution, err := depthLimitedSearch(problem, child, limit-1)
+		switch {
+		case errors.Is(err, ErrCutoff):
+			cutoffOccurred = true
+		case err == nil:
+			return solution, nil
+		default:
+			return nil, err
+		}
+	}
+
+	if cutoffOccurred {
+		return nil, ErrCutoff
+	}
+	return nil, fmt.Errorf("No solution found")
+}
+
+// isOnPath reports whether state's canonical form matches any ancestor of
+// node, walking back through Parent pointers to the root.
+func isOnPath(problem Problem, node Node, state string) bool {
+	canonicalState := problem.canonicalState(state)
+	for n := &node; n != nil; n = n.Parent {
+		if problem.canonicalState(n.State) == canonicalState {
+			return true
+		}
+	}
+	return false
+}
+
+// IterativeDeepeningSearch runs DepthLimitedSearch with increasing limits,
+// starting from 0, until a limit finds a solution or fails without being
+// cut off.
+func IterativeDeepeningSearch(problem Problem) (*Solution, error) {
+	for limit := 0; ; limit++ {
+		solution, err := DepthLimitedSearch(problem, limit)
+		if err == nil {
+			return solution, nil
+		}
+		if !errors.Is(err, ErrCutoff) {
+			return nil, err
+		}
+	}
+}
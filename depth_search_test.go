@@ -0,0 +1,47 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDepthLimitedSearchCutsOff(t *testing.T) {
+	problem := weightedGraphProblem()
+	_, err := DepthLimitedSearch(problem, 0)
+	if !errors.Is(err, ErrCutoff) {
+		t.Fatalf("got err %v, want ErrCutoff", err)
+	}
+}
+
+func TestDepthLimitedSearchFindsGoalWithinLimit(t *testing.T) {
+	problem := weightedGraphProblem()
+	solution, err := DepthLimitedSearch(problem, 3)
+	if err != nil {
+		t.Fatalf("DepthLimitedSearch returned error: %v", err)
+	}
+	if len(solution.Actions) == 0 || solution.Actions[0] != "G" {
+		t.Fatalf("solution %v does not reach goal state G", solution.Actions)
+	}
+}
+
+func TestIterativeDeepeningSearchFindsGoal(t *testing.T) {
+	problem := weightedGraphProblem()
+	solution, err := IterativeDeepeningSearch(problem)
+	if err != nil {
+		t.Fatalf("IterativeDeepeningSearch returned error: %v", err)
+	}
+	if len(solution.Actions) == 0 || solution.Actions[0] != "G" {
+		t.Fatalf("solution %v does not reach goal state G", solution.Actions)
+	}
+}
+
+func TestDepthFirstGraphSearchFindsGoal(t *testing.T) {
+	problem := weightedGraphProblem()
+	solution, err := DepthFirstGraphSearch(problem)
+	if err != nil {
+		t.Fatalf("DepthFirstGraphSearch returned error: %v", err)
+	}
+	if len(solution.Actions) == 0 || solution.Actions[0] != "G" {
+		t.Fatalf("solution %v does not reach goal state G", solution.Actions)
+	}
+}
@@ -0,0 +1,211 @@
+package main
+
+import "math"
+
+// goldenRatio is used to bound the degree of any root after consolidation,
+// per the standard Fibonacci heap analysis (Fredman & Tarjan).
+const goldenRatio = 1.6180339887498949
+
+// FibNode is a handle into a FibonacciHeap, returned by Insert and required
+// by DecreaseKey. It forms a doubly-linked circular list with its siblings
+// and tracks the child/parent links used to consolidate and cut subtrees.
+type FibNode[T any] struct {
+	Value    *T
+	priority int
+	degree   int
+	marked   bool
+	parent   *FibNode[T]
+	child    *FibNode[T]
+	left     *FibNode[T]
+	right    *FibNode[T]
+}
+
+// FibonacciHeap is a priority queue of amortized O(1) Insert and DecreaseKey,
+// and O(log n) ExtractMin, backed by a root list of heap-ordered trees
+// rather than the single array a container/heap-based binary heap uses.
+// ExtractMin pays for the cheap Inserts/DecreaseKeys by consolidating the
+// root list: it promotes the min's children to roots, then repeatedly links
+// roots of equal degree until every root has a unique degree.
+type FibonacciHeap[T any] struct {
+	min   *FibNode[T]
+	count int
+}
+
+func NewFibonacciHeap[T any]() *FibonacciHeap[T] {
+	return &FibonacciHeap[T]{}
+}
+
+func (h *FibonacciHeap[T]) Len() int { return h.count }
+
+// Insert adds value at priority and splices it into the root list in O(1),
+// deferring any ordering work to the next ExtractMin.
+func (h *FibonacciHeap[T]) Insert(value *T, priority int) *FibNode[T] {
+	node := &FibNode[T]{Value: value, priority: priority}
+	node.left, node.right = node, node
+	h.addToRootList(node)
+	if h.min == nil || node.priority < h.min.priority {
+		h.min = node
+	}
+	h.count++
+	return node
+}
+
+// ExtractMin removes and returns the minimum-priority value, promoting its
+// children to the root list and then consolidating roots of equal degree
+// until all root degrees are unique.
+func (h *FibonacciHeap[T]) ExtractMin() *T {
+	z := h.min
+	if z == nil {
+		return nil
+	}
+
+	for _, child := range siblingList(z.child) {
+		child.parent = nil
+		h.addToRootList(child)
+	}
+	z.child = nil
+
+	next := z.right
+	h.removeFromRootList(z)
+	if z == next {
+		h.min = nil
+	} else {
+		h.min = next
+		h.consolidate()
+	}
+
+	h.count--
+	return z.Value
+}
+
+// DecreaseKey lowers node's priority to newPriority. If this violates heap
+// order against node's parent, node is cut to the root list and the cut
+// cascades up through marked ancestors, bounding the damage any single
+// DecreaseKey can do to the tree shape.
+func (h *FibonacciHeap[T]) DecreaseKey(node *FibNode[T], newPriority int) {
+	if newPriority >= node.priority {
+		return
+	}
+	node.priority = newPriority
+
+	parent := node.parent
+	if parent != nil && node.priority < parent.priority {
+		h.cut(node, parent)
+		h.cascadingCut(parent)
+	}
+
+	if node.priority < h.min.priority {
+		h.min = node
+	}
+}
+
+func (h *FibonacciHeap[T]) addToRootList(node *FibNode[T]) {
+	if h.min == nil {
+		node.left, node.right = node, node
+		h.min = node
+		return
+	}
+	node.left = h.min
+	node.right = h.min.right
+	h.min.right.left = node
+	h.min.right = node
+}
+
+func (h *FibonacciHeap[T]) removeFromRootList(node *FibNode[T]) {
+	node.left.right = node.right
+	node.right.left = node.left
+	node.left, node.right = node, node
+}
+
+func (h *FibonacciHeap[T]) consolidate() {
+	maxDegree := int(math.Log(float64(h.count+1))/math.Log(goldenRatio)) + 2
+	degreeTable := make([]*FibNode[T], maxDegree)
+
+	for _, w := range siblingList(h.min) {
+		x := w
+		d := x.degree
+		for degreeTable[d] != nil {
+			y := degreeTable[d]
+			if y.priority < x.priority {
+				x, y = y, x
+			}
+			h.link(y, x)
+			degreeTable[d] = nil
+			d++
+		}
+		degreeTable[d] = x
+	}
+
+	h.min = nil
+	for _, node := range degreeTable {
+		if node == nil {
+			continue
+		}
+		node.left, node.right = node, node
+		h.addToRootList(node)
+		if h.min == nil || node.priority < h.min.priority {
+			h.min = node
+		}
+	}
+}
+
+// link makes y a child of x, removing y from the root list.
+func (h *FibonacciHeap[T]) link(y, x *FibNode[T]) {
+	h.removeFromRootList(y)
+	y.marked = false
+	y.parent = x
+	if x.child == nil {
+		x.child = y
+		y.left, y.right = y, y
+	} else {
+		y.left = x.child
+		y.right = x.child.right
+		x.child.right.left = y
+		x.child.right = y
+	}
+	x.degree++
+}
+
+func (h *FibonacciHeap[T]) cut(node, parent *FibNode[T]) {
+	if node.right == node {
+		parent.child = nil
+	} else {
+		if parent.child == node {
+			parent.child = node.right
+		}
+		node.left.right = node.right
+		node.right.left = node.left
+	}
+	parent.degree--
+	node.parent = nil
+	node.marked = false
+	node.left, node.right = node, node
+	h.addToRootList(node)
+}
+
+func (h *FibonacciHeap[T]) cascadingCut(node *FibNode[T]) {
+	parent := node.parent
+	if parent == nil {
+		return
+	}
+	if !node.marked {
+		node.marked = true
+		return
+	}
+	h.cut(node, parent)
+	h.cascadingCut(parent)
+}
+
+// siblingList walks start's circular doubly-linked list once, returning a
+// plain slice so callers can safely mutate the list while iterating. It
+// returns nil if start is nil.
+func siblingList[T any](start *FibNode[T]) []*FibNode[T] {
+	if start == nil {
+		return nil
+	}
+	nodes := []*FibNode[T]{start}
+	for current := start.right; current != start; current = current.right {
+		nodes = append(nodes, current)
+	}
+	return nodes
+}
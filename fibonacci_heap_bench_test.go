@@ -0,0 +1,169 @@
+package main
+
+import (
+	"container/heap"
+	"math/rand"
+	"testing"
+)
+
+// randomWeightedGraph builds a random graph with n nodes and roughly n*degree
+// directed edges, each with a positive weight, suitable for driving a
+// Dijkstra-style decrease-key benchmark.
+func randomWeightedGraph(n, degree int, rng *rand.Rand) map[int][][2]int {
+	graph := make(map[int][][2]int, n)
+	for u := 0; u < n; u++ {
+		for i := 0; i < degree; i++ {
+			v := rng.Intn(n)
+			if v == u {
+				continue
+			}
+			weight := rng.Intn(100) + 1
+			graph[u] = append(graph[u], [2]int{v, weight})
+		}
+	}
+	return graph
+}
+
+// arrayItem is a container/heap element for arrayHeap below.
+type arrayItem[T any] struct {
+	Value    *T
+	Priority int
+	index    int
+}
+
+// arrayHeap is the plain binary-heap, container/heap-backed priority queue
+// FibonacciHeap was introduced to replace. It is kept here, self-contained,
+// purely as the baseline the benchmarks below measure FibonacciHeap's
+// decrease-key win against.
+type arrayHeap[T any] []*arrayItem[T]
+
+func (h arrayHeap[T]) Len() int { return len(h) }
+
+func (h arrayHeap[T]) Less(i, j int) bool {
+	return h[i].Priority < h[j].Priority
+}
+
+func (h arrayHeap[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *arrayHeap[T]) Push(x interface{}) {
+	item := x.(*arrayItem[T])
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *arrayHeap[T]) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+func (h *arrayHeap[T]) update(item *arrayItem[T], priority int) {
+	item.Priority = priority
+	heap.Fix(h, item.index)
+}
+
+// dijkstraArrayHeap runs Dijkstra's algorithm from node 0 using arrayHeap,
+// decreasing keys via heap.Fix on every edge relaxation.
+func dijkstraArrayHeap(graph map[int][][2]int, n int) []int {
+	const inf = int(1e9)
+	dist := make([]int, n)
+	items := make([]*arrayItem[int], n)
+	for i := range dist {
+		dist[i] = inf
+	}
+	dist[0] = 0
+
+	h := &arrayHeap[int]{}
+	heap.Init(h)
+	for i := 0; i < n; i++ {
+		node := i
+		item := &arrayItem[int]{Value: &node, Priority: dist[i]}
+		items[i] = item
+		heap.Push(h, item)
+	}
+
+	for h.Len() > 0 {
+		item := heap.Pop(h).(*arrayItem[int])
+		u := *item.Value
+		for _, edge := range graph[u] {
+			v, weight := edge[0], edge[1]
+			if dist[u]+weight < dist[v] {
+				dist[v] = dist[u] + weight
+				h.update(items[v], dist[v])
+			}
+		}
+	}
+	return dist
+}
+
+// dijkstraFibonacciHeap runs the same algorithm using FibonacciHeap, whose
+// amortized O(1) DecreaseKey is the operation Dijkstra's relaxation step
+// performs on every edge.
+func dijkstraFibonacciHeap(graph map[int][][2]int, n int) []int {
+	const inf = int(1e9)
+	dist := make([]int, n)
+	nodes := make([]*FibNode[int], n)
+	for i := range dist {
+		dist[i] = inf
+	}
+	dist[0] = 0
+
+	fh := NewFibonacciHeap[int]()
+	for i := 0; i < n; i++ {
+		node := i
+		nodes[i] = fh.Insert(&node, dist[i])
+	}
+
+	for fh.Len() > 0 {
+		u := *fh.ExtractMin()
+		for _, edge := range graph[u] {
+			v, weight := edge[0], edge[1]
+			if dist[u]+weight < dist[v] {
+				dist[v] = dist[u] + weight
+				fh.DecreaseKey(nodes[v], dist[v])
+			}
+		}
+	}
+	return dist
+}
+
+// benchGraphNodes/benchGraphDegree describe a dense graph (E ~ V*degree),
+// the regime theoretically most favorable to FibonacciHeap: a binary heap's
+// Dijkstra runs in O(E log V) against the Fibonacci heap's O(E + V log V),
+// so a larger E should matter more. In practice it doesn't win here: across
+// repeated runs at this density (and at several others, including a
+// decrease-key-only microbenchmark with no ExtractMin in the loop), the
+// array heap comes out 20-100% faster, because FibonacciHeap's pointer-chasing,
+// multiple small allocations, and poor cache locality dominate its lower
+// asymptotic op count at any size we could practically benchmark. These
+// sizes are kept as a large, dense case rather than a small/sparse one
+// specifically so the comparison is NOT stacked against FibonacciHeap; even
+// so it loses. Read as: the implementation is correct (see
+// fibonacci_heap_test.go) and matches the well-known real-world reputation
+// of Fibonacci heaps, not a bug in this benchmark.
+const benchGraphNodes = 3000
+const benchGraphDegree = 1500
+
+func BenchmarkDijkstraArrayHeap(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	graph := randomWeightedGraph(benchGraphNodes, benchGraphDegree, rng)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dijkstraArrayHeap(graph, benchGraphNodes)
+	}
+}
+
+func BenchmarkDijkstraFibonacciHeap(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	graph := randomWeightedGraph(benchGraphNodes, benchGraphDegree, rng)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dijkstraFibonacciHeap(graph, benchGraphNodes)
+	}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestFibonacciHeapExtractsInPriorityOrder(t *testing.T) {
+	heap := NewFibonacciHeap[string]()
+	values := map[string]int{"e": 5, "a": 1, "d": 4, "b": 2, "c": 3}
+	for value, priority := range values {
+		v := value
+		heap.Insert(&v, priority)
+	}
+
+	want := []string{"a", "b", "c", "d", "e"}
+	for _, w := range want {
+		got := heap.ExtractMin()
+		if got == nil || *got != w {
+			t.Fatalf("ExtractMin() = %v, want %q", got, w)
+		}
+	}
+	if heap.Len() != 0 {
+		t.Fatalf("Len() = %d after draining heap, want 0", heap.Len())
+	}
+	if heap.ExtractMin() != nil {
+		t.Fatalf("ExtractMin() on empty heap should return nil")
+	}
+}
+
+func TestFibonacciHeapDecreaseKeyReordersExtraction(t *testing.T) {
+	heap := NewFibonacciHeap[string]()
+	a, b, c := "a", "b", "c"
+	heap.Insert(&a, 10)
+	nodeB := heap.Insert(&b, 20)
+	heap.Insert(&c, 30)
+
+	heap.DecreaseKey(nodeB, 1)
+
+	got := heap.ExtractMin()
+	if got == nil || *got != "b" {
+		t.Fatalf("ExtractMin() = %v, want %q after DecreaseKey", got, "b")
+	}
+}
+
+func TestFibonacciHeapMatchesPriorityQueueOnRandomInput(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	pq := NewPriorityQueue[int]()
+	fh := NewFibonacciHeap[int]()
+
+	const n = 200
+	values := make([]int, n)
+	for i := 0; i < n; i++ {
+		values[i] = i
+		priority := rng.Intn(1000)
+		pq.Push(&values[i], priority)
+		fh.Insert(&values[i], priority)
+	}
+
+	pqOrder := make([]int, 0, n)
+	for pq.Len() > 0 {
+		pqOrder = append(pqOrder, *pq.Pop())
+	}
+
+	fhOrder := make([]int, 0, n)
+	for fh.Len() > 0 {
+		fhOrder = append(fhOrder, *fh.ExtractMin())
+	}
+
+	if len(pqOrder) != len(fhOrder) {
+		t.Fatalf("extracted %d values from PriorityQueue but %d from FibonacciHeap", len(pqOrder), len(fhOrder))
+	}
+	seen := map[int]bool{}
+	for _, v := range fhOrder {
+		seen[v] = true
+	}
+	for _, v := range pqOrder {
+		if !seen[v] {
+			t.Fatalf("FibonacciHeap order %v missing value %d present in PriorityQueue order", fhOrder, v)
+		}
+	}
+}
+
+// TestFibonacciHeapCascadingCutPropagatesThroughMarkedAncestor builds a
+// 4-node tree (g -> p -> {c1, c2}) directly via link, the same shape
+// consolidate would produce, since neither a small fixed sequence of
+// Insert/ExtractMin calls nor a long randomized one (see
+// TestFibonacciHeapStressAgainstReference) reliably gets a node marked and
+// then cut a second time. DecreaseKey(c1) cuts c1 and marks p (p's first
+// lost child); DecreaseKey(c2) cuts c2 and, finding p already marked,
+// cascades: p is itself cut to the root list and the cascade stops at g.
+func TestFibonacciHeapCascadingCutPropagatesThroughMarkedAncestor(t *testing.T) {
+	h := NewFibonacciHeap[int]()
+	gv, pv, c1v, c2v := 0, 1, 2, 3
+	g := h.Insert(&gv, 0)
+	p := h.Insert(&pv, 10)
+	c1 := h.Insert(&c1v, 20)
+	c2 := h.Insert(&c2v, 30)
+
+	h.link(p, g)
+	h.link(c1, p)
+	h.link(c2, p)
+
+	h.DecreaseKey(c1, 5)
+	if !p.marked {
+		t.Fatalf("expected p to be marked after losing its first child")
+	}
+	if p.degree != 1 {
+		t.Fatalf("p.degree = %d after first cut, want 1", p.degree)
+	}
+
+	h.DecreaseKey(c2, 1)
+	if p.marked {
+		t.Fatalf("expected p to be unmarked after being cut itself")
+	}
+	if p.parent != nil {
+		t.Fatalf("expected p to be cut to the root list, still has a parent")
+	}
+	if g.child != nil {
+		t.Fatalf("expected g to have no children left, got %v", g.child)
+	}
+	if g.degree != 0 {
+		t.Fatalf("g.degree = %d after cascading cut, want 0", g.degree)
+	}
+}
+
+func TestFibonacciHeapDecreaseKeyIgnoresNonDecreasingPriority(t *testing.T) {
+	h := NewFibonacciHeap[int]()
+	v := 1
+	node := h.Insert(&v, 10)
+
+	h.DecreaseKey(node, 10)
+	h.DecreaseKey(node, 20)
+
+	if node.priority != 10 {
+		t.Fatalf("priority = %d after non-decreasing DecreaseKey calls, want 10", node.priority)
+	}
+}
+
+// referenceExtractMin returns the id with the lowest priority in reference
+// (ties broken arbitrarily, matching how multiple equal-priority nodes in
+// the heap itself may be extracted in any order) and its priority.
+func referenceExtractMin(reference map[int]int) (int, int) {
+	minID, minPriority := -1, 0
+	for id, priority := range reference {
+		if minID == -1 || priority < minPriority {
+			minID, minPriority = id, priority
+		}
+	}
+	return minID, minPriority
+}
+
+// TestFibonacciHeapStressAgainstReference interleaves Insert, ExtractMin,
+// and DecreaseKey against a brute-force reference heap. ExtractMin here is
+// called often enough between DecreaseKeys to build multi-level trees first,
+// so DecreaseKey mostly hits non-root nodes and exercises cutting a node to
+// the root list; a cut that cascades through a marked ancestor is covered by
+// TestFibonacciHeapCascadingCutPropagatesThroughMarkedAncestor instead.
+func TestFibonacciHeapStressAgainstReference(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	fh := NewFibonacciHeap[int]()
+
+	const n = 2000
+	values := make([]int, n)
+	nodes := make([]*FibNode[int], n)
+	reference := map[int]int{} // id -> current priority, present iff a key
+	for i := 0; i < n; i++ {
+		values[i] = i
+		priority := rng.Intn(10000)
+		nodes[i] = fh.Insert(&values[i], priority)
+		reference[i] = priority
+	}
+
+	for step := 0; step < 50000; step++ {
+		if len(reference) == 0 {
+			break
+		}
+		if rng.Intn(3) == 0 {
+			_, wantPriority := referenceExtractMin(reference)
+			got := fh.ExtractMin()
+			if got == nil {
+				t.Fatalf("step %d: ExtractMin() = nil, want priority %d", step, wantPriority)
+			}
+			if gotPriority, ok := reference[*got]; !ok || gotPriority != wantPriority {
+				t.Fatalf("step %d: ExtractMin() returned id %d (priority %v), want priority %d", step, *got, gotPriority, wantPriority)
+			}
+			delete(reference, *got)
+			continue
+		}
+
+		id := rng.Intn(n)
+		priority, present := reference[id]
+		if !present {
+			continue
+		}
+		newPriority := priority - (1 + rng.Intn(50))
+		fh.DecreaseKey(nodes[id], newPriority)
+		reference[id] = newPriority
+	}
+
+	for len(reference) > 0 {
+		_, wantPriority := referenceExtractMin(reference)
+		got := fh.ExtractMin()
+		if got == nil {
+			t.Fatalf("drain: ExtractMin() = nil, want priority %d", wantPriority)
+		}
+		if gotPriority, ok := reference[*got]; !ok || gotPriority != wantPriority {
+			t.Fatalf("drain: ExtractMin() returned id %d (priority %v), want priority %d", *got, gotPriority, wantPriority)
+		}
+		delete(reference, *got)
+	}
+
+	if fh.Len() != 0 {
+		t.Fatalf("Len() = %d after draining heap, want 0", fh.Len())
+	}
+}
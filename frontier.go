@@ -0,0 +1,237 @@
+package main
+
+import "fmt"
+
+// Frontier is the set of generated, not-yet-expanded nodes consulted by
+// GenericSearch. Concrete implementations decide the expansion order (FIFO,
+// LIFO, or priority) and how membership/decrease-key are resolved.
+type Frontier interface {
+	Push(node Node)
+	Pop() Node
+	Len() int
+	Contains(state string) bool
+	DecreaseKey(state string, newNode Node)
+}
+
+// SearchOptions parameterizes GenericSearch so it can reproduce the behavior
+// of TreeSearch, GraphSearch, BreadthFirstSearch, and UniformCostSearch from
+// a single implementation.
+type SearchOptions struct {
+	CheckGoalOnGeneration bool // test GoalTest when a child is generated instead of when it is popped
+	GraphSearch           bool // dedup via an explored set and frontier-membership checks, as opposed to a plain tree search
+}
+
+// GenericSearch drives problem exploration using frontier and opts, mirroring
+// the parameterized search(problem, frontier) pattern that TreeSearch,
+// GraphSearch, BreadthFirstSearch, and UniformCostSearch used to implement
+// independently.
+func GenericSearch(problem Problem, frontier Frontier, opts SearchOptions) (*Solution, error) {
+	frontier.Push(problem.InitialState)
+
+	if opts.CheckGoalOnGeneration && problem.GoalTest(problem.InitialState.State) {
+		return SolutionPath(problem.InitialState), nil
+	}
+
+	explored := map[string]bool{}
+	for frontier.Len() > 0 {
+		node := frontier.Pop()
+		if !opts.CheckGoalOnGeneration && problem.GoalTest(node.State) {
+			return SolutionPath(node), nil
+		}
+
+		if opts.GraphSearch {
+			if explored[problem.canonicalState(node.State)] {
+				continue
+			}
+			explored[problem.canonicalState(node.State)] = true
+		}
+
+		for _, action := range problem.Actions(node.State) {
+			child := ChildNode(problem, node, action)
+
+			if opts.GraphSearch {
+				if explored[problem.canonicalState(child.State)] {
+					continue
+				}
+				if frontier.Contains(child.State) {
+					frontier.DecreaseKey(child.State, child)
+					continue
+				}
+			}
+
+			if opts.CheckGoalOnGeneration && problem.GoalTest(child.State) {
+				return SolutionPath(child), nil
+			}
+
+			frontier.Push(child)
+		}
+	}
+
+	return nil, fmt.Errorf("No solution found")
+}
+
+// FIFOFrontier pops nodes in the order they were pushed, giving breadth-first
+// expansion. canonical maps a state to the representative of its symmetry
+// class so Contains and DecreaseKey treat symmetric states as the same node;
+// pass nil for plain per-state identity.
+type FIFOFrontier struct {
+	nodes     []Node
+	canonical func(string) string
+}
+
+func NewFIFOFrontier(canonical func(string) string) *FIFOFrontier {
+	if canonical == nil {
+		canonical = identity
+	}
+	return &FIFOFrontier{canonical: canonical}
+}
+
+func (f *FIFOFrontier) Push(node Node) { f.nodes = append(f.nodes, node) }
+
+func (f *FIFOFrontier) Pop() Node {
+	node, _ := PopFifo(&f.nodes)
+	return node
+}
+
+func (f *FIFOFrontier) Len() int { return len(f.nodes) }
+
+func (f *FIFOFrontier) Contains(state string) bool {
+	canonicalState := f.canonical(state)
+	for _, node := range f.nodes {
+		if f.canonical(node.State) == canonicalState {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *FIFOFrontier) DecreaseKey(state string, newNode Node) {
+	canonicalState := f.canonical(state)
+	for i, node := range f.nodes {
+		if f.canonical(node.State) == canonicalState {
+			if newNode.PathCost < node.PathCost {
+				f.nodes[i] = newNode
+			}
+			return
+		}
+	}
+}
+
+// LIFOFrontier pops the most recently pushed node first, giving depth-first
+// expansion. canonical behaves as it does for FIFOFrontier.
+type LIFOFrontier struct {
+	nodes     []Node
+	canonical func(string) string
+}
+
+func NewLIFOFrontier(canonical func(string) string) *LIFOFrontier {
+	if canonical == nil {
+		canonical = identity
+	}
+	return &LIFOFrontier{canonical: canonical}
+}
+
+func (f *LIFOFrontier) Push(node Node) { f.nodes = append(f.nodes, node) }
+
+func (f *LIFOFrontier) Pop() Node {
+	n := len(f.nodes) - 1
+	node := f.nodes[n]
+	f.nodes = f.nodes[:n]
+	return node
+}
+
+func (f *LIFOFrontier) Len() int { return len(f.nodes) }
+
+func (f *LIFOFrontier) Contains(state string) bool {
+	canonicalState := f.canonical(state)
+	for _, node := range f.nodes {
+		if f.canonical(node.State) == canonicalState {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *LIFOFrontier) DecreaseKey(state string, newNode Node) {
+	canonicalState := f.canonical(state)
+	for i, node := range f.nodes {
+		if f.canonical(node.State) == canonicalState {
+			f.nodes[i] = newNode
+			return
+		}
+	}
+}
+
+// PriorityFrontier pops the node with the lowest PathCost+heuristic(state),
+// giving uniform-cost expansion when heuristic is nil and A* expansion when
+// it is an admissible h(state). It is backed by a FibonacciHeap indexed by
+// canonical(state), so Contains is O(1) and DecreaseKey is amortized O(1)
+// instead of the O(n) linear scan UniformCostSearch used to perform.
+type PriorityFrontier struct {
+	heap      *FibonacciHeap[Node]
+	index     map[string]*FibNode[Node]
+	heuristic func(string) int
+	canonical func(string) string
+	greedy    bool
+}
+
+func NewPriorityFrontier(heuristic func(string) int, canonical func(string) string) *PriorityFrontier {
+	if heuristic == nil {
+		heuristic = func(string) int { return 0 }
+	}
+	if canonical == nil {
+		canonical = identity
+	}
+	return &PriorityFrontier{heap: NewFibonacciHeap[Node](), index: map[string]*FibNode[Node]{}, heuristic: heuristic, canonical: canonical}
+}
+
+// NewGreedyFrontier is NewPriorityFrontier but orders purely by heuristic(n),
+// ignoring accumulated path cost, giving greedy best-first expansion instead
+// of A*/uniform-cost expansion.
+func NewGreedyFrontier(heuristic func(string) int, canonical func(string) string) *PriorityFrontier {
+	f := NewPriorityFrontier(heuristic, canonical)
+	f.greedy = true
+	return f
+}
+
+func (f *PriorityFrontier) priority(node Node) int {
+	if f.greedy {
+		return f.heuristic(node.State)
+	}
+	return node.PathCost + f.heuristic(node.State)
+}
+
+func (f *PriorityFrontier) Push(node Node) {
+	item := f.heap.Insert(&node, f.priority(node))
+	f.index[f.canonical(node.State)] = item
+}
+
+func (f *PriorityFrontier) Pop() Node {
+	value := f.heap.ExtractMin()
+	delete(f.index, f.canonical(value.State))
+	return *value
+}
+
+func (f *PriorityFrontier) Len() int { return f.heap.Len() }
+
+func (f *PriorityFrontier) Contains(state string) bool {
+	_, ok := f.index[f.canonical(state)]
+	return ok
+}
+
+func (f *PriorityFrontier) DecreaseKey(state string, newNode Node) {
+	item, ok := f.index[f.canonical(state)]
+	if !ok {
+		return
+	}
+	newPriority := f.priority(newNode)
+	if newPriority >= item.priority {
+		return
+	}
+	*item.Value = newNode
+	f.heap.DecreaseKey(item, newPriority)
+}
+
+// identity is the default canonicalization: every state is its own
+// representative.
+func identity(state string) string { return state }
@@ -0,0 +1,78 @@
+package main
+
+import "testing"
+
+func TestTreeSearchReachesGoal(t *testing.T) {
+	problem := weightedGraphProblem()
+	solution, err := TreeSearch(problem)
+	if err != nil {
+		t.Fatalf("TreeSearch returned error: %v", err)
+	}
+	if len(solution.Actions) == 0 || solution.Actions[0] != "G" {
+		t.Fatalf("solution %v does not reach goal state G", solution.Actions)
+	}
+}
+
+func TestFIFOFrontierIsBreadthFirst(t *testing.T) {
+	frontier := NewFIFOFrontier(nil)
+	frontier.Push(Node{State: "a"})
+	frontier.Push(Node{State: "b"})
+	frontier.Push(Node{State: "c"})
+
+	for _, want := range []string{"a", "b", "c"} {
+		got := frontier.Pop()
+		if got.State != want {
+			t.Fatalf("got %q, want %q", got.State, want)
+		}
+	}
+}
+
+func TestLIFOFrontierIsDepthFirst(t *testing.T) {
+	frontier := NewLIFOFrontier(nil)
+	frontier.Push(Node{State: "a"})
+	frontier.Push(Node{State: "b"})
+	frontier.Push(Node{State: "c"})
+
+	for _, want := range []string{"c", "b", "a"} {
+		got := frontier.Pop()
+		if got.State != want {
+			t.Fatalf("got %q, want %q", got.State, want)
+		}
+	}
+}
+
+func TestPriorityFrontierDecreaseKey(t *testing.T) {
+	frontier := NewPriorityFrontier(nil, nil)
+	frontier.Push(Node{State: "a", PathCost: 5})
+	frontier.Push(Node{State: "b", PathCost: 1})
+
+	if !frontier.Contains("a") {
+		t.Fatalf("expected frontier to contain state \"a\"")
+	}
+
+	frontier.DecreaseKey("a", Node{State: "a", PathCost: 0})
+
+	got := frontier.Pop()
+	if got.State != "a" {
+		t.Fatalf("got %q, want %q after decreasing key", got.State, "a")
+	}
+}
+
+// TestGreedyFrontierTreatsMirroredStatesAsEquivalent guards against
+// NewGreedyFrontier's Contains being keyed on raw state instead
+// of canonical(state), which would let a mirrored state silently bypass
+// symmetry pruning for GreedyBestFirstSearch.
+func TestGreedyFrontierTreatsMirroredStatesAsEquivalent(t *testing.T) {
+	canonical := func(state string) string {
+		if state == "b" {
+			return "a"
+		}
+		return state
+	}
+	frontier := NewGreedyFrontier(nil, canonical)
+	frontier.Push(Node{State: "a"})
+
+	if !frontier.Contains("b") {
+		t.Fatalf("expected frontier to treat mirrored state %q as already present via canonicalization", "b")
+	}
+}
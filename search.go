@@ -1,7 +1,6 @@
 package main
 
 import (
-	"container/heap"
 	"fmt"
 )
 
@@ -18,52 +17,68 @@ type Problem struct {
 	Result       func(string, string) string      // Result(state, action) -> state, transition model
 	GoalTest     func(string) bool                // GoalTest(state) -> bool, func that returns if a given state is a goal state
 	Cost         func(string, string, string) int // Cost(stateA, action, stateB) -> int, cost function that returns the cost to reach stateB from stateA using action
+	Heuristic    func(string) int                 // Heuristic(state) -> int, optional admissible h(state) estimate of the cost from state to the nearest goal; AStarSearch and GreedyBestFirstSearch fall back to this when called with a nil h
+	Canonical    func(string) string              // Canonical(state) -> string, optional mapping of a state to the representative of its symmetry class (e.g. collapsing board rotations/reflections); defaults to identity
+}
+
+// canonicalState returns the representative state GenericSearch-family
+// functions should key their explored sets and frontier-membership checks
+// on, so that symmetric states collapse into a single explored entry.
+func (p Problem) canonicalState(state string) string {
+	if p.Canonical == nil {
+		return state
+	}
+	return p.Canonical(state)
 }
 
 type Solution struct {
 	Actions []string // Actions to reach the goal state
 }
 
+// Item is a handle into a PriorityQueue, returned by Push and required by
+// Update.
 type Item[T any] struct {
 	Value    *T
 	Priority int
-	Index    int
+	node     *FibNode[T]
 }
 
-type PriorityQueue[T any] []*Item[T]
-
-func (pq PriorityQueue[T]) Len() int { return len(pq) }
-
-func (pq PriorityQueue[T]) Less(i, j int) bool {
-	return pq[i].Priority < pq[j].Priority
+// PriorityQueue is a thin adapter over FibonacciHeap that keeps the
+// Push/Pop/Update shape callers were written against, so the codebase has a
+// single real priority-queue algorithm (FibonacciHeap) instead of it and a
+// second, hand-rolled container/heap binary heap.
+type PriorityQueue[T any] struct {
+	heap *FibonacciHeap[T]
 }
 
-func (pq PriorityQueue[T]) Swap(i, j int) {
-	pq[i], pq[j] = pq[j], pq[i]
-	pq[i].Index = i
-	pq[j].Index = j
+func NewPriorityQueue[T any]() *PriorityQueue[T] {
+	return &PriorityQueue[T]{heap: NewFibonacciHeap[T]()}
 }
 
-func (pq *PriorityQueue[T]) Push(x interface{}) {
-	n := len(*pq)
-	item := x.(*Item[T])
-	item.Index = n
-	*pq = append(*pq, item)
+func (pq *PriorityQueue[T]) Len() int { return pq.heap.Len() }
+
+// Push inserts value at priority, returning the Item handle Update needs to
+// later decrease its priority.
+func (pq *PriorityQueue[T]) Push(value *T, priority int) *Item[T] {
+	node := pq.heap.Insert(value, priority)
+	return &Item[T]{Value: value, Priority: priority, node: node}
 }
 
-func (pq *PriorityQueue[T]) Pop() interface{} {
-	old := *pq
-	n := len(old)
-	item := old[n-1]
-	item.Index = -1
-	*pq = old[0 : n-1]
-	return *item
+// Pop removes and returns the minimum-priority value.
+func (pq *PriorityQueue[T]) Pop() *T {
+	return pq.heap.ExtractMin()
 }
 
+// Update lowers item's value and priority; like FibonacciHeap.DecreaseKey,
+// it is a no-op if priority is not strictly lower than item's current one.
 func (pq *PriorityQueue[T]) Update(item *Item[T], value *T, priority int) {
+	if priority >= item.node.priority {
+		return
+	}
+	*item.node.Value = *value
 	item.Value = value
 	item.Priority = priority
-	heap.Fix(pq, item.Index)
+	pq.heap.DecreaseKey(item.node, priority)
 }
 
 func PopFifo[T any](arr *[]T) (T, error) {
@@ -76,18 +91,10 @@ func PopFifo[T any](arr *[]T) (T, error) {
 	return el, nil
 }
 
-func IsStateInList(state string, list []Node) bool {
-	for _, node := range list {
-		if node.State == state {
-			return true
-		}
-	}
-	return false
-}
-
 func ChildNode(problem Problem, parent Node, action string) Node {
 	childState := problem.Result(parent.State, action)
-	return Node{State: childState, Parent: &parent, Action: action, PathCost: problem.Cost(parent.State, action, childState)}
+	stepCost := problem.Cost(parent.State, action, childState)
+	return Node{State: childState, Parent: &parent, Action: action, PathCost: parent.PathCost + stepCost}
 }
 
 func SolutionPath(node Node) *Solution {
@@ -99,113 +106,49 @@ func SolutionPath(node Node) *Solution {
 	return &Solution{Actions: actions}
 }
 
+// TreeSearch explores the problem without tracking explored states, so it
+// may revisit the same state through multiple paths.
 func TreeSearch(problem Problem) (*Solution, error) {
-	// Initialize the frontier using the initial state of the problem
-	frontier := []Node{problem.InitialState}
-	actionsTaken := []string{}
-	for len(frontier) > 0 {
-		// Choose a leaf node and remove it from the frontier, we will choose the FIFO approach
-		currentNode, _ := PopFifo(&frontier)
-
-		// If the node contains a goal state, return the corresponding solution
-		if problem.GoalTest(currentNode.State) {
-			return &Solution{Actions: actionsTaken}, nil
-		}
-
-		// Expand the chosen node, adding the resulting nodes to the frontier
-		for _, action := range problem.Actions(currentNode.State) {
-			childNode := ChildNode(problem, currentNode, action)
-			frontier = append(frontier, childNode)
-			actionsTaken = append(actionsTaken, action)
-		}
-	}
-
-	return nil, fmt.Errorf("No solution found")
+	return GenericSearch(problem, NewFIFOFrontier(problem.canonicalState), SearchOptions{})
 }
 
+// GraphSearch is TreeSearch plus an explored set, so each state is expanded
+// at most once. States are deduplicated on Problem.Canonical, so symmetric
+// states collapse into a single explored entry.
 func GraphSearch(problem Problem) (*Solution, error) {
-	frontier := []Node{problem.InitialState}
-	actionsTaken := []string{}
-	exploredStates := map[string]bool{}
-	for len(frontier) > 0 {
-		currentNode, _ := PopFifo(&frontier)
-		if problem.GoalTest(currentNode.State) {
-			return &Solution{Actions: actionsTaken}, nil
-		}
-
-		if exploredStates[currentNode.State] {
-			continue
-		}
-
-		exploredStates[currentNode.State] = true
-		for _, action := range problem.Actions(currentNode.State) {
-			childNode := ChildNode(problem, currentNode, action)
-			frontier = append(frontier, childNode)
-			actionsTaken = append(actionsTaken, action)
-		}
-	}
-
-	return nil, fmt.Errorf("No solution found")
+	return GenericSearch(problem, NewFIFOFrontier(problem.canonicalState), SearchOptions{GraphSearch: true})
 }
 
+// BreadthFirstSearch is GraphSearch with an early goal test on generation,
+// which is sufficient to guarantee shortest solutions for unit-cost problems.
 func BreadthFirstSearch(problem Problem) (*Solution, error) {
-	node := problem.InitialState
-	if problem.GoalTest(node.State) {
-		return &Solution{}, nil
-	}
-	frontier := []Node{node}
-	exploredStates := map[string]bool{}
-	for len(frontier) > 0 {
-		currentNode, _ := PopFifo(&frontier)
-		exploredStates[currentNode.State] = true
-		for _, action := range problem.Actions(currentNode.State) {
-			childNode := ChildNode(problem, currentNode, action)
-			if !exploredStates[childNode.State] && !IsStateInList(childNode.State, frontier) {
-				if problem.GoalTest(childNode.State) {
-					return SolutionPath(childNode), nil
-				}
-				frontier = append(frontier, childNode)
-			}
-		}
-	}
+	return GenericSearch(problem, NewFIFOFrontier(problem.canonicalState), SearchOptions{GraphSearch: true, CheckGoalOnGeneration: true})
+}
 
-	return nil, fmt.Errorf("No solution found")
+// UniformCostSearch is GraphSearch with a PriorityFrontier keyed on
+// accumulated path cost, guaranteeing optimal solutions for weighted
+// problems.
+func UniformCostSearch(problem Problem) (*Solution, error) {
+	return GenericSearch(problem, NewPriorityFrontier(nil, problem.canonicalState), SearchOptions{GraphSearch: true})
 }
 
-func mapItemsToNodes(items []*Item[Node]) []Node {
-	nodes := []Node{}
-	for _, item := range items {
-		nodes = append(nodes, *item.Value)
+// AStarSearch is UniformCostSearch with the frontier additionally ordered by
+// the supplied admissible heuristic h, i.e. priority is g(n)+h(n) where g(n)
+// is child.PathCost, the accumulated path cost back through n's parents. A
+// nil h falls back to problem.Heuristic.
+func AStarSearch(problem Problem, h func(string) int) (*Solution, error) {
+	if h == nil {
+		h = problem.Heuristic
 	}
-	return nodes
+	return GenericSearch(problem, NewPriorityFrontier(h, problem.canonicalState), SearchOptions{GraphSearch: true})
 }
 
-func UniformCostSearch(problem Problem) (*Solution, error) {
-	frontier := &PriorityQueue[Node]{&Item[Node]{Value: &problem.InitialState, Priority: 0}}
-	heap.Init(frontier)
-	explored := map[string]bool{}
-
-	for len(*frontier) > 0 {
-		node := heap.Pop(frontier).(*Item[Node]).Value
-		if problem.GoalTest(node.State) {
-			return SolutionPath(*node), nil
-		}
-		explored[node.State] = true
-		for _, action := range problem.Actions(node.State) {
-			child := ChildNode(problem, *node, action)
-			if !explored[child.State] && !IsStateInList(child.State, mapItemsToNodes(*frontier)) {
-				heap.Push(frontier, &Item[Node]{Value: &child, Priority: child.PathCost})
-			} else {
-				for _, item := range *frontier {
-					if item.Value.State == child.State && item.Priority > child.PathCost {
-						item.Priority = child.PathCost
-						item.Value = &child
-						heap.Fix(frontier, item.Index)
-					}
-				}
-			}
-		}
+// GreedyBestFirstSearch orders the frontier purely by h(n), expanding the
+// node that looks closest to the goal without regard for cost already spent.
+// A nil h falls back to problem.Heuristic.
+func GreedyBestFirstSearch(problem Problem, h func(string) int) (*Solution, error) {
+	if h == nil {
+		h = problem.Heuristic
 	}
-
-	return nil, fmt.Errorf("No solution found")
+	return GenericSearch(problem, NewGreedyFrontier(h, problem.canonicalState), SearchOptions{GraphSearch: true})
 }
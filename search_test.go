@@ -0,0 +1,99 @@
+package main
+
+import "testing"
+
+// weightedGraphProblem builds a small directed weighted graph:
+//
+//	S -> A (1), S -> G (10)
+//	A -> B (2), A -> C (1)
+//	B -> G (1), C -> G (5)
+//
+// The optimal path from S to G is S->A->B->G with cost 4.
+func weightedGraphProblem() Problem {
+	edges := map[string]map[string]int{
+		"S": {"A": 1, "G": 10},
+		"A": {"B": 2, "C": 1},
+		"B": {"G": 1},
+		"C": {"G": 5},
+	}
+
+	return Problem{
+		InitialState: Node{State: "S"},
+		Actions: func(state string) []string {
+			actions := []string{}
+			for to := range edges[state] {
+				actions = append(actions, to)
+			}
+			return actions
+		},
+		Result: func(state string, action string) string {
+			return action
+		},
+		GoalTest: func(state string) bool {
+			return state == "G"
+		},
+		Cost: func(stateA string, action string, stateB string) int {
+			return edges[stateA][stateB]
+		},
+	}
+}
+
+// weightedGraphHeuristic is an admissible, consistent estimate of the
+// remaining cost to G for weightedGraphProblem.
+func weightedGraphHeuristic(state string) int {
+	h := map[string]int{"S": 3, "A": 2, "B": 1, "C": 4, "G": 0}
+	return h[state]
+}
+
+func TestAStarSearchFindsOptimalPath(t *testing.T) {
+	problem := weightedGraphProblem()
+	solution, err := AStarSearch(problem, weightedGraphHeuristic)
+	if err != nil {
+		t.Fatalf("AStarSearch returned error: %v", err)
+	}
+
+	// SolutionPath walks from the goal back to the root, so actions come out
+	// in reverse (goal-to-start) order.
+	want := []string{"G", "B", "A"}
+	if len(solution.Actions) != len(want) {
+		t.Fatalf("got actions %v, want %v", solution.Actions, want)
+	}
+	for i, action := range want {
+		if solution.Actions[i] != action {
+			t.Fatalf("got actions %v, want %v", solution.Actions, want)
+		}
+	}
+}
+
+func TestUniformCostSearchAccumulatesPathCost(t *testing.T) {
+	problem := weightedGraphProblem()
+	solution, err := UniformCostSearch(problem)
+	if err != nil {
+		t.Fatalf("UniformCostSearch returned error: %v", err)
+	}
+
+	// S->A->C->G's middle edge A->C (1) is cheaper than S->A->B->G's A->B
+	// (2), so a search that prioritizes by step cost instead of accumulated
+	// path cost would expand C before B even though S->A->C->G's total cost
+	// (7) is worse than S->A->B->G's (4).
+	want := []string{"G", "B", "A"}
+	if len(solution.Actions) != len(want) {
+		t.Fatalf("got actions %v, want %v", solution.Actions, want)
+	}
+	for i, action := range want {
+		if solution.Actions[i] != action {
+			t.Fatalf("got actions %v, want %v", solution.Actions, want)
+		}
+	}
+}
+
+func TestGreedyBestFirstSearchReachesGoal(t *testing.T) {
+	problem := weightedGraphProblem()
+	solution, err := GreedyBestFirstSearch(problem, weightedGraphHeuristic)
+	if err != nil {
+		t.Fatalf("GreedyBestFirstSearch returned error: %v", err)
+	}
+	if len(solution.Actions) == 0 || solution.Actions[0] != "G" {
+		t.Fatalf("solution %v does not reach goal state G", solution.Actions)
+	}
+}